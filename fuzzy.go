@@ -0,0 +1,295 @@
+package gostringlist
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Score weights for fuzzy matching. The weights are ordered so that each criterion only breaks
+// ties left by the one before it: span length dominates boundary bonuses, which in turn dominate
+// the overall-length tiebreaker, so combining them into a single int preserves strict precedence.
+// The length tiebreaker is clamped to fuzzyMaxLengthPenalty so that it can never grow large enough
+// to outweigh a single boundary bonus, no matter how long an item is.
+const (
+	fuzzySpanWeight       = 1000000
+	fuzzyBoundaryWeight   = 1000
+	fuzzyMaxLengthPenalty = fuzzyBoundaryWeight - 1
+)
+
+// FuzzyOptions controls the behavior of FuzzyFilter.
+type FuzzyOptions struct {
+	// Exact requires the query to match as a contiguous substring instead of an in-order,
+	// possibly-noncontiguous subsequence.
+	Exact bool
+
+	// MaxResults caps the number of items returned, keeping only the highest-scoring matches.
+	// A value of 0 means no cap.
+	MaxResults int
+}
+
+// FuzzyMatch describes how a single StringList item matched a fuzzy query.
+type FuzzyMatch struct {
+	// Index is the position of the matched item in the original StringList.
+	Index int
+
+	// Score is the match's rank score. Higher scores sort first.
+	Score int
+
+	// Positions holds the byte offsets within the item of each matched query character, in order.
+	Positions []int
+}
+
+// FuzzyRank scores every item in the list against query using fzf-style fuzzy matching and
+// returns the matching items ordered from best to worst match. Characters of query must appear in
+// order in a candidate, though not necessarily contiguously. Matching is case-insensitive if query
+// is written entirely in lowercase ("smart case") and case-sensitive otherwise.
+func (list StringList) FuzzyRank(query string) []FuzzyMatch {
+	caseSensitive := smartCase(query)
+
+	matches := make([]FuzzyMatch, 0, len(list.Items))
+	for i, item := range list.Items {
+		if match, ok := fuzzyMatchItem(item, query, caseSensitive, false); ok {
+			match.Index = i
+			matches = append(matches, match)
+		}
+	}
+
+	sortFuzzyMatches(matches)
+	return matches
+}
+
+// FuzzyFilter returns a new StringList containing the items which fuzzy-match query, ordered from
+// best to worst match. If opts is nil, default options are used (fuzzy, uncapped). Set
+// opts.Exact to require a contiguous substring match instead, and opts.MaxResults to bound the
+// number of items returned.
+func (list StringList) FuzzyFilter(query string, opts *FuzzyOptions) StringList {
+	if opts == nil {
+		opts = &FuzzyOptions{}
+	}
+	caseSensitive := smartCase(query)
+
+	matches := make([]FuzzyMatch, 0, len(list.Items))
+	for i, item := range list.Items {
+		if match, ok := fuzzyMatchItem(item, query, caseSensitive, opts.Exact); ok {
+			match.Index = i
+			matches = append(matches, match)
+		}
+	}
+
+	sortFuzzyMatches(matches)
+	if opts.MaxResults > 0 && len(matches) > opts.MaxResults {
+		matches = matches[:opts.MaxResults]
+	}
+
+	var newList StringList
+	newList.Items = make([]string, len(matches))
+	for i, match := range matches {
+		newList.Items[i] = list.Items[match.Index]
+	}
+
+	return newList
+}
+
+// smartCase returns true if query should be matched case-sensitively, i.e. it contains at least
+// one uppercase letter.
+func smartCase(query string) bool {
+	for _, r := range query {
+		if unicode.IsUpper(r) {
+			return true
+		}
+	}
+	return false
+}
+
+// sortFuzzyMatches orders matches from best to worst, breaking score ties by original index so
+// results are stable.
+func sortFuzzyMatches(matches []FuzzyMatch) {
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].Score != matches[j].Score {
+			return matches[i].Score > matches[j].Score
+		}
+		return matches[i].Index < matches[j].Index
+	})
+}
+
+// fuzzyMatchItem attempts to match query against item and, on success, returns a FuzzyMatch with
+// its score and matched positions populated (Index is left for the caller to fill in).
+func fuzzyMatchItem(item, query string, caseSensitive, exact bool) (FuzzyMatch, bool) {
+	if query == "" {
+		return FuzzyMatch{}, false
+	}
+
+	var start, end int
+	var ok bool
+	if exact {
+		start, end, ok = exactMatchSpan(item, query, caseSensitive)
+	} else {
+		start, end, ok = fuzzyMatchSpan(item, query, caseSensitive)
+	}
+	if !ok {
+		return FuzzyMatch{}, false
+	}
+
+	positions := matchPositions(item, query, caseSensitive, start, end)
+	score := fuzzySpanWeight*-(end-start) + fuzzyBoundaryWeight*boundaryBonus(item, positions) - lengthPenalty(item)
+
+	return FuzzyMatch{Score: score, Positions: positions}, true
+}
+
+// exactMatchSpan returns the byte range of query's first contiguous occurrence in item.
+func exactMatchSpan(item, query string, caseSensitive bool) (start, end int, ok bool) {
+	if !caseSensitive {
+		// Compare rune by rune: lowercasing can change byte lengths, and the span must index item.
+		runes, positions := indexedRunes(item)
+		queryRunes := []rune(query)
+		for i := 0; i+len(queryRunes) <= len(runes); i++ {
+			j := 0
+			for j < len(queryRunes) && runeEquals(runes[i+j], queryRunes[j], false) {
+				j++
+			}
+			if j == len(queryRunes) {
+				return positions[i], positions[i+j-1] + runeByteLen(runes[i+j-1]), true
+			}
+		}
+		return 0, 0, false
+	}
+
+	idx := strings.Index(item, query)
+	if idx < 0 {
+		return 0, 0, false
+	}
+	return idx, idx + len(query), true
+}
+
+// fuzzyMatchSpan finds a tight byte range in item which contains query's characters in order. It
+// runs a forward pass to find the earliest position at which every query character has been seen,
+// then a backward pass from there to pull the start of the range as far right as possible,
+// yielding the shortest span that ends at that earliest position. A shorter in-order match which
+// ends later in item is not searched for.
+func fuzzyMatchSpan(item, query string, caseSensitive bool) (start, end int, ok bool) {
+	runes, positions := indexedRunes(item)
+	queryRunes := []rune(query)
+	if len(queryRunes) == 0 {
+		return 0, 0, false
+	}
+
+	qi := 0
+	lastPos := -1
+	for i, r := range runes {
+		if runeEquals(r, queryRunes[qi], caseSensitive) {
+			qi++
+			lastPos = i
+			if qi == len(queryRunes) {
+				break
+			}
+		}
+	}
+	if qi != len(queryRunes) {
+		return 0, 0, false
+	}
+	end = positions[lastPos] + runeByteLen(runes[lastPos])
+
+	qi = len(queryRunes) - 1
+	startIdx := lastPos
+	for i := lastPos; i >= 0; i-- {
+		if runeEquals(runes[i], queryRunes[qi], caseSensitive) {
+			startIdx = i
+			qi--
+			if qi < 0 {
+				break
+			}
+		}
+	}
+	start = positions[startIdx]
+
+	return start, end, true
+}
+
+// matchPositions re-runs a forward greedy match within item[start:end] to recover the byte offset
+// of each matched query character, for use in highlighting.
+func matchPositions(item, query string, caseSensitive bool, start, end int) []int {
+	queryRunes := []rune(query)
+	positions := make([]int, 0, len(queryRunes))
+
+	qi := 0
+	for i, r := range item[start:end] {
+		if qi == len(queryRunes) {
+			break
+		}
+		if runeEquals(r, queryRunes[qi], caseSensitive) {
+			positions = append(positions, start+i)
+			qi++
+		}
+	}
+
+	return positions
+}
+
+// boundaryBonus counts how many of the given byte positions in item fall on a word boundary: the
+// start of the string, just after one of "/_-. ", or a lowercase-to-uppercase (camelCase)
+// transition. Matches on boundaries read better to a human scanning results, so they're rewarded.
+func boundaryBonus(item string, positions []int) int {
+	bonus := 0
+	for _, pos := range positions {
+		if pos == 0 {
+			bonus++
+			continue
+		}
+
+		prev := lastRune(item[:pos])
+		switch {
+		case strings.ContainsRune("/_-. ", prev):
+			bonus++
+		case unicode.IsLower(prev) && unicode.IsUpper(firstRune(item[pos:])):
+			bonus++
+		}
+	}
+	return bonus
+}
+
+// indexedRunes returns item's runes along with the byte offset of each one.
+func indexedRunes(item string) ([]rune, []int) {
+	runes := make([]rune, 0, len(item))
+	positions := make([]int, 0, len(item))
+	for i, r := range item {
+		runes = append(runes, r)
+		positions = append(positions, i)
+	}
+	return runes, positions
+}
+
+func runeEquals(a, b rune, caseSensitive bool) bool {
+	if caseSensitive {
+		return a == b
+	}
+	return unicode.ToLower(a) == unicode.ToLower(b)
+}
+
+func runeByteLen(r rune) int {
+	return len(string(r))
+}
+
+// lengthPenalty returns item's length, capped at fuzzyMaxLengthPenalty so that the length
+// tiebreaker can never grow large enough to overturn a boundary-bonus comparison.
+func lengthPenalty(item string) int {
+	if len(item) > fuzzyMaxLengthPenalty {
+		return fuzzyMaxLengthPenalty
+	}
+	return len(item)
+}
+
+func firstRune(s string) rune {
+	for _, r := range s {
+		return r
+	}
+	return 0
+}
+
+func lastRune(s string) rune {
+	var last rune
+	for _, r := range s {
+		last = r
+	}
+	return last
+}
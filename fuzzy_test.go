@@ -0,0 +1,76 @@
+package gostringlist
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFuzzyRank(t *testing.T) {
+	var list StringList
+	list.Items = []string{"xfxmx", "f_max", "nothing here"}
+
+	matches := list.FuzzyRank("fm")
+	if len(matches) != 2 {
+		t.Fatalf("FuzzyRank returned %d matches, wanted 2", len(matches))
+	}
+
+	// Both matches span the same 3 bytes ("fxm" vs "f_m"), so span length can't separate them.
+	// "f_max" matches at two word boundaries (start of string and after '_'), while "xfxmx"
+	// matches at none, so it should rank first.
+	if matches[0].Index != 1 {
+		t.Fatalf("FuzzyRank ranked boundary match lower than non-boundary match: %+v", matches)
+	}
+
+	if len(list.FuzzyRank("zzz")) != 0 {
+		t.Fatal("FuzzyRank matched a query with no possible subsequence")
+	}
+}
+
+func TestFuzzyRankLengthCannotOverturnBoundaryBonus(t *testing.T) {
+	var list StringList
+
+	// Both items match "abc" with an identical 3-byte span, but only the second matches at a
+	// word boundary (the start of the string). The second item is also much longer; the length
+	// tiebreaker must not be able to outweigh that boundary bonus.
+	list.Items = []string{"xxabcxxx", "abc" + strings.Repeat("z", 2000)}
+
+	matches := list.FuzzyRank("abc")
+	if len(matches) != 2 {
+		t.Fatalf("FuzzyRank returned %d matches, wanted 2", len(matches))
+	}
+
+	if matches[0].Index != 1 {
+		t.Fatalf("FuzzyRank let item length overturn a boundary-bonus comparison: %+v", matches)
+	}
+}
+
+func TestFuzzyFilter(t *testing.T) {
+	var list StringList
+	list.Items = []string{"apple", "Banana", "grape", "pineapple"}
+
+	outlist := list.FuzzyFilter("ap", nil)
+	if outlist.IsEmpty() || !outlist.Contains("apple") || !outlist.Contains("grape") || !outlist.Contains("pineapple") {
+		t.Fatalf("FuzzyFilter failed to find expected matches\n%s\n", outlist.ToString())
+	}
+
+	// Smart case: an uppercase query character forces case-sensitive matching.
+	outlist = list.FuzzyFilter("B", nil)
+	if !outlist.IsEqual(StringList{Items: []string{"Banana"}}) {
+		t.Fatalf("FuzzyFilter failed to respect smart case\n%s\n", outlist.ToString())
+	}
+
+	outlist = list.FuzzyFilter("ple", &FuzzyOptions{MaxResults: 1})
+	if len(outlist.Items) != 1 {
+		t.Fatalf("FuzzyFilter failed to respect MaxResults\n%s\n", outlist.ToString())
+	}
+
+	outlist = list.FuzzyFilter("appple", &FuzzyOptions{Exact: true})
+	if !outlist.IsEmpty() {
+		t.Fatalf("FuzzyFilter Exact mode matched a noncontiguous query\n%s\n", outlist.ToString())
+	}
+
+	outlist = list.FuzzyFilter("apple", &FuzzyOptions{Exact: true})
+	if !outlist.IsEqual(StringList{Items: []string{"apple", "pineapple"}}) {
+		t.Fatalf("FuzzyFilter Exact mode failed to find contiguous matches\n%s\n", outlist.ToString())
+	}
+}
@@ -0,0 +1,43 @@
+package gostringlist
+
+import "github.com/gobwas/glob"
+
+// CompileGlob compiles pattern into a reusable Glob matcher. Callers matching many lists (or many
+// items) against the same pattern should compile it once with this function and call the returned
+// matcher's Match() method directly, since GlobFilter and GlobMatch recompile the pattern on every
+// call.
+func CompileGlob(pattern string) (glob.Glob, error) {
+	return glob.Compile(pattern)
+}
+
+// GlobMatch returns true if str matches the supplied shell-style wildcard pattern, e.g. "*", "?",
+// "**", character classes, and alternates like "{foo,bar}". The pattern is compiled on each call,
+// so if you are matching many strings against the same pattern, compile it once with CompileGlob
+// and call its Match() method directly instead.
+func GlobMatch(pattern string, str string) (bool, error) {
+	g, err := glob.Compile(pattern)
+	if err != nil {
+		return false, err
+	}
+	return g.Match(str), nil
+}
+
+// GlobFilter returns a new StringList containing all the items in the list which match the
+// supplied shell-style wildcard pattern. It is a faster, more ergonomic alternative to
+// MatchFilter's regular expressions for the common case of path/name matching.
+func (list StringList) GlobFilter(pattern string) (StringList, error) {
+	var newList StringList
+	g, err := glob.Compile(pattern)
+	if err != nil {
+		return newList, err
+	}
+
+	newList.Items = make([]string, 0, len(list.Items))
+	for _, item := range list.Items {
+		if g.Match(item) {
+			newList.Items = append(newList.Items, item)
+		}
+	}
+
+	return newList, nil
+}
@@ -0,0 +1,59 @@
+package gostringlist
+
+import "testing"
+
+func TestGlobMatch(t *testing.T) {
+	matched, err := GlobMatch("*.txt", "notes.txt")
+	if err != nil || !matched {
+		t.Fatal("GlobMatch failed to match a valid pattern")
+	}
+
+	matched, err = GlobMatch("*.txt", "notes.md")
+	if err != nil || matched {
+		t.Fatal("GlobMatch matched a string it shouldn't have")
+	}
+
+	_, err = GlobMatch("[", "notes.txt")
+	if err == nil {
+		t.Fatal("GlobMatch failed to catch a bad pattern")
+	}
+}
+
+func TestCompileGlob(t *testing.T) {
+	g, err := CompileGlob("report-{jan,feb}.csv")
+	if err != nil {
+		t.Fatal("CompileGlob failed to compile a valid pattern")
+	}
+
+	if !g.Match("report-jan.csv") || g.Match("report-mar.csv") {
+		t.Fatal("CompileGlob returned a matcher that matched incorrectly")
+	}
+
+	_, err = CompileGlob("[")
+	if err == nil {
+		t.Fatal("CompileGlob failed to catch a bad pattern")
+	}
+}
+
+func TestGlobFilter(t *testing.T) {
+	var inlist, compareList StringList
+
+	inlist.Items = []string{"apple.txt", "banana.md", "orange.txt", "pear.csv"}
+	compareList.Items = []string{"apple.txt", "orange.txt"}
+
+	outlist, err := inlist.GlobFilter("*.txt")
+	if err != nil || !outlist.IsEqual(compareList) {
+		t.Fatalf("GlobFilter failed to process list correctly\n%s\n", outlist.ToString())
+	}
+
+	// Test case where there are no matches
+	outlist, err = inlist.GlobFilter("*.pdf")
+	if err != nil || !outlist.IsEmpty() {
+		t.Fatalf("GlobFilter failed to handle no matches correctly\n%s\n", outlist.ToString())
+	}
+
+	_, err = inlist.GlobFilter("[")
+	if err == nil {
+		t.Fatal("GlobFilter failed to catch a bad pattern")
+	}
+}
@@ -0,0 +1,109 @@
+package gostringlist
+
+import (
+	"index/suffixarray"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// indexSeparator is inserted between items when building a StringListIndex so that a match can
+// never span two items. It is unlikely to appear in ordinary text; if it does, the worst case is
+// a false positive merge between adjacent items.
+const indexSeparator = "\x00"
+
+// StringListIndex is a snapshot index built from a StringList which allows substring searches to
+// run in logarithmic time instead of the linear scans used by Contains, IndexOf, and MatchFilter.
+// Regular expression searches use the index only to find where the pattern's literal prefix
+// occurs; a pattern with no literal prefix is matched against the whole buffer in linear time.
+// Because it is a snapshot, changes made to the source StringList after
+// calling BuildIndex() are not reflected in the index; call BuildIndex() again to pick them up.
+type StringListIndex struct {
+	sa     *suffixarray.Index
+	data   []byte
+	starts []int
+}
+
+// BuildIndex concatenates the list's items, separated by a sentinel byte, into a single buffer and
+// constructs a suffix array over it. The returned StringListIndex is a snapshot of the list's
+// contents at the time of the call.
+func (list StringList) BuildIndex() *StringListIndex {
+	data := []byte(strings.Join(list.Items, indexSeparator))
+	starts := make([]int, len(list.Items))
+	offset := 0
+	for i, item := range list.Items {
+		starts[i] = offset
+		offset += len(item) + len(indexSeparator)
+	}
+
+	return &StringListIndex{
+		sa:     suffixarray.New(data),
+		data:   data,
+		starts: starts,
+	}
+}
+
+// itemAt returns the index of the item containing byte offset pos.
+func (index *StringListIndex) itemAt(pos int) int {
+	return sort.Search(len(index.starts), func(i int) bool {
+		return index.starts[i] > pos
+	}) - 1
+}
+
+// IndexSubstring returns the indices of the items which contain sub, in ascending order. Each
+// matching item appears at most once, regardless of how many times sub occurs within it.
+func (index *StringListIndex) IndexSubstring(sub string) []int {
+	offsets := index.sa.Lookup([]byte(sub), -1)
+	return index.uniqueItemsAt(offsets)
+}
+
+// CountSubstring returns the number of items which contain sub.
+func (index *StringListIndex) CountSubstring(sub string) int {
+	return len(index.IndexSubstring(sub))
+}
+
+// FindAll returns the indices of the items which contain a match of re, in ascending order. Each
+// matching item appears at most once, regardless of how many times re matches within it. Matches
+// that span the sentinel between two items (e.g. a pattern like "a.*b" bridging the end of one
+// item and the start of the next) are not counted, since they don't represent a real match within
+// either item; instead each item such a match covers is tested against re on its own. The
+// pattern runs over the joined buffer, so the anchors ^ and $ match only at its two ends, not at
+// the start and end of every item.
+func (index *StringListIndex) FindAll(re *regexp.Regexp) []int {
+	matches := index.sa.FindAllIndex(re, -1)
+	offsets := make([]int, 0, len(matches))
+	for _, match := range matches {
+		start, end := match[0], match[1]
+		if end > start && index.itemAt(start) != index.itemAt(end-1) {
+			for item := index.itemAt(start); item <= index.itemAt(end-1); item++ {
+				itemEnd := len(index.data)
+				if item+1 < len(index.starts) {
+					itemEnd = index.starts[item+1] - len(indexSeparator)
+				}
+				if re.Match(index.data[index.starts[item]:itemEnd]) {
+					offsets = append(offsets, index.starts[item])
+				}
+			}
+			continue
+		}
+		offsets = append(offsets, start)
+	}
+	return index.uniqueItemsAt(offsets)
+}
+
+// uniqueItemsAt maps a set of byte offsets into index.data back to their owning item indices,
+// returning the distinct item indices in ascending order.
+func (index *StringListIndex) uniqueItemsAt(offsets []int) []int {
+	seen := make(map[int]bool, len(offsets))
+	items := make([]int, 0, len(offsets))
+	for _, offset := range offsets {
+		item := index.itemAt(offset)
+		if !seen[item] {
+			seen[item] = true
+			items = append(items, item)
+		}
+	}
+
+	sort.Ints(items)
+	return items
+}
@@ -0,0 +1,69 @@
+package gostringlist
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+func TestBuildIndexSubstring(t *testing.T) {
+	var list StringList
+	list.Items = []string{"apple pie", "banana split", "apple tart", "orange juice"}
+
+	index := list.BuildIndex()
+
+	got := index.IndexSubstring("apple")
+	want := []int{0, 2}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("IndexSubstring returned %v, wanted %v", got, want)
+	}
+
+	got = index.IndexSubstring("nothing")
+	if len(got) != 0 {
+		t.Fatalf("IndexSubstring found matches for a nonexistent substring: %v", got)
+	}
+}
+
+func TestCountSubstring(t *testing.T) {
+	var list StringList
+	list.Items = []string{"apple pie", "banana split", "apple tart", "orange juice"}
+
+	index := list.BuildIndex()
+
+	if index.CountSubstring("apple") != 2 {
+		t.Fatal("CountSubstring failed to count matching items correctly")
+	}
+
+	if index.CountSubstring("nothing") != 0 {
+		t.Fatal("CountSubstring failed to handle no matches correctly")
+	}
+}
+
+func TestFindAll(t *testing.T) {
+	var list StringList
+	list.Items = []string{"apple pie", "banana split", "apple tart", "orange juice"}
+
+	index := list.BuildIndex()
+
+	re := regexp.MustCompile("apple \\w+")
+	got := index.FindAll(re)
+	want := []int{0, 2}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("FindAll returned %v, wanted %v", got, want)
+	}
+}
+
+func TestFindAllDoesNotCrossItemBoundary(t *testing.T) {
+	var list StringList
+	list.Items = []string{"fooa", "bbar"}
+
+	index := list.BuildIndex()
+
+	// "a.*b" could only match by bridging the sentinel between "fooa" and "bbar"; neither item
+	// actually contains a match, so FindAll must report none.
+	re := regexp.MustCompile("a.*b")
+	got := index.FindAll(re)
+	if len(got) != 0 {
+		t.Fatalf("FindAll matched across an item boundary: %v", got)
+	}
+}
@@ -0,0 +1,139 @@
+package gostringlist
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+)
+
+// ReaderOptions configures NewFromReader and AppendFromReader.
+type ReaderOptions struct {
+	// Separator is the byte which delimits items. The zero value uses bufio.ScanLines, which
+	// splits on "\n" and strips a trailing "\r".
+	Separator byte
+
+	// MaxLineSize caps the size of a single item read from the stream. The zero value uses
+	// bufio.MaxScanTokenSize.
+	MaxLineSize int
+}
+
+// NewFromReader reads line-delimited (or, per opts, custom-delimited) items from r into a new
+// StringList. Pass nil for opts to use the defaults: split on "\n" with bufio's default max token
+// size.
+func NewFromReader(r io.Reader, opts *ReaderOptions) (*StringList, error) {
+	list := New()
+	if err := list.AppendFromReader(r, opts); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// NewFromFile opens path and reads its contents into a new StringList the same way NewFromReader
+// does, using the default ReaderOptions.
+func NewFromFile(path string) (*StringList, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return NewFromReader(file, nil)
+}
+
+// AppendFromReader reads items from r and appends them to the list, for incrementally ingesting a
+// stream in pieces. Pass nil for opts to use the defaults.
+func (list *StringList) AppendFromReader(r io.Reader, opts *ReaderOptions) error {
+	if opts == nil {
+		opts = &ReaderOptions{}
+	}
+
+	scanner := bufio.NewScanner(r)
+	if opts.Separator != 0 && opts.Separator != '\n' {
+		scanner.Split(splitOnByte(opts.Separator))
+	}
+	if opts.MaxLineSize > 0 {
+		scanner.Buffer(make([]byte, 0, minInt(opts.MaxLineSize, 64*1024)), opts.MaxLineSize)
+	}
+
+	for scanner.Scan() {
+		list.Append(scanner.Text())
+	}
+
+	return scanner.Err()
+}
+
+// splitOnByte returns a bufio.SplitFunc which delimits tokens on sep instead of "\n".
+func splitOnByte(sep byte) bufio.SplitFunc {
+	return func(data []byte, atEOF bool) (advance int, token []byte, err error) {
+		if atEOF && len(data) == 0 {
+			return 0, nil, nil
+		}
+
+		if i := bytes.IndexByte(data, sep); i >= 0 {
+			return i + 1, data[:i], nil
+		}
+
+		if atEOF {
+			return len(data), data, nil
+		}
+
+		return 0, nil, nil
+	}
+}
+
+// WriteTo writes the list's items to w, joined by sep, and returns the number of bytes written.
+func (list StringList) WriteTo(w io.Writer, sep string) (int64, error) {
+	var written int64
+
+	for i, item := range list.Items {
+		if i > 0 {
+			n, err := io.WriteString(w, sep)
+			written += int64(n)
+			if err != nil {
+				return written, err
+			}
+		}
+
+		n, err := io.WriteString(w, item)
+		written += int64(n)
+		if err != nil {
+			return written, err
+		}
+	}
+
+	return written, nil
+}
+
+// MarshalJSON marshals the list as a plain JSON array of its items, rather than an object wrapping
+// the Items field.
+func (list StringList) MarshalJSON() ([]byte, error) {
+	return json.Marshal(list.Items)
+}
+
+// UnmarshalJSON unmarshals a plain JSON array of strings into the list's items.
+func (list *StringList) UnmarshalJSON(data []byte) error {
+	var items []string
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	list.Items = items
+	return nil
+}
+
+// MarshalYAML marshals the list as a plain YAML sequence of its items, rather than a mapping
+// wrapping the Items field.
+func (list StringList) MarshalYAML() (interface{}, error) {
+	return list.Items, nil
+}
+
+// UnmarshalYAML unmarshals a plain YAML sequence of strings into the list's items.
+func (list *StringList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var items []string
+	if err := unmarshal(&items); err != nil {
+		return err
+	}
+	list.Items = items
+	return nil
+}
@@ -0,0 +1,109 @@
+package gostringlist
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v2"
+)
+
+func TestNewFromReader(t *testing.T) {
+	var compareList StringList
+	compareList.Items = []string{"apple", "banana", "cherry"}
+
+	list, err := NewFromReader(strings.NewReader("apple\nbanana\ncherry\n"), nil)
+	if err != nil || !list.IsEqual(compareList) {
+		t.Fatalf("NewFromReader failed to read list correctly\n%s\n", list.ToString())
+	}
+
+	list, err = NewFromReader(strings.NewReader("apple,banana,cherry"), &ReaderOptions{Separator: ','})
+	if err != nil || !list.IsEqual(compareList) {
+		t.Fatalf("NewFromReader failed to honor a custom separator\n%s\n", list.ToString())
+	}
+}
+
+func TestAppendFromReader(t *testing.T) {
+	var compareList StringList
+	compareList.Items = []string{"apple", "banana", "cherry"}
+
+	list := New()
+	list.Append("apple")
+	if err := list.AppendFromReader(strings.NewReader("banana\ncherry"), nil); err != nil {
+		t.Fatalf("AppendFromReader returned an error: %s", err)
+	}
+	if !list.IsEqual(compareList) {
+		t.Fatalf("AppendFromReader failed to append items correctly\n%s\n", list.ToString())
+	}
+}
+
+func TestNewFromFile(t *testing.T) {
+	var compareList StringList
+	compareList.Items = []string{"apple", "banana", "cherry"}
+
+	path := filepath.Join(t.TempDir(), "list.txt")
+	if err := os.WriteFile(path, []byte("apple\nbanana\ncherry\n"), 0600); err != nil {
+		t.Fatalf("failed to write test fixture: %s", err)
+	}
+
+	list, err := NewFromFile(path)
+	if err != nil || !list.IsEqual(compareList) {
+		t.Fatalf("NewFromFile failed to read list correctly\n%s\n", list.ToString())
+	}
+}
+
+func TestWriteTo(t *testing.T) {
+	var list StringList
+	list.Items = []string{"apple", "banana", "cherry"}
+
+	var buf strings.Builder
+	n, err := list.WriteTo(&buf, ", ")
+	if err != nil {
+		t.Fatalf("WriteTo returned an error: %s", err)
+	}
+
+	want := "apple, banana, cherry"
+	if buf.String() != want || int(n) != len(want) {
+		t.Fatalf("WriteTo wrote %q (%d bytes), wanted %q (%d bytes)", buf.String(), n, want, len(want))
+	}
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	var list, list2 StringList
+	list.Items = []string{"apple", "banana", "cherry"}
+
+	data, err := json.Marshal(list)
+	if err != nil {
+		t.Fatalf("MarshalJSON returned an error: %s", err)
+	}
+
+	if string(data) != `["apple","banana","cherry"]` {
+		t.Fatalf("MarshalJSON produced %s, wanted a plain array", data)
+	}
+
+	if err := json.Unmarshal(data, &list2); err != nil {
+		t.Fatalf("UnmarshalJSON returned an error: %s", err)
+	}
+	if !list.IsEqual(list2) {
+		t.Fatalf("JSON round trip failed to preserve items\n%s\n", list2.ToString())
+	}
+}
+
+func TestYAMLRoundTrip(t *testing.T) {
+	var list, list2 StringList
+	list.Items = []string{"apple", "banana", "cherry"}
+
+	data, err := yaml.Marshal(list)
+	if err != nil {
+		t.Fatalf("MarshalYAML returned an error: %s", err)
+	}
+
+	if err := yaml.Unmarshal(data, &list2); err != nil {
+		t.Fatalf("UnmarshalYAML returned an error: %s", err)
+	}
+	if !list.IsEqual(list2) {
+		t.Fatalf("YAML round trip failed to preserve items\n%s\n", list2.ToString())
+	}
+}
@@ -0,0 +1,286 @@
+package gostringlist
+
+// Union returns a new StringList containing the distinct items from both lists, in the order
+// they first appear (this list's items, then other's).
+func (list StringList) Union(other StringList) StringList {
+	seen := make(map[string]struct{}, len(list.Items)+len(other.Items))
+	var newList StringList
+	newList.Items = make([]string, 0, len(list.Items)+len(other.Items))
+
+	for _, item := range list.Items {
+		if _, ok := seen[item]; !ok {
+			seen[item] = struct{}{}
+			newList.Items = append(newList.Items, item)
+		}
+	}
+	for _, item := range other.Items {
+		if _, ok := seen[item]; !ok {
+			seen[item] = struct{}{}
+			newList.Items = append(newList.Items, item)
+		}
+	}
+
+	return newList
+}
+
+// Intersect returns a new StringList containing the distinct items which appear in both lists, in
+// the order they appear in this list.
+func (list StringList) Intersect(other StringList) StringList {
+	otherSet := toSet(other.Items)
+	seen := make(map[string]struct{}, len(list.Items))
+	var newList StringList
+	newList.Items = make([]string, 0, len(list.Items))
+
+	for _, item := range list.Items {
+		if _, ok := otherSet[item]; !ok {
+			continue
+		}
+		if _, ok := seen[item]; ok {
+			continue
+		}
+		seen[item] = struct{}{}
+		newList.Items = append(newList.Items, item)
+	}
+
+	return newList
+}
+
+// Difference returns a new StringList containing the distinct items of this list which do not
+// appear in other, in the order they appear in this list.
+func (list StringList) Difference(other StringList) StringList {
+	otherSet := toSet(other.Items)
+	seen := make(map[string]struct{}, len(list.Items))
+	var newList StringList
+	newList.Items = make([]string, 0, len(list.Items))
+
+	for _, item := range list.Items {
+		if _, ok := otherSet[item]; ok {
+			continue
+		}
+		if _, ok := seen[item]; ok {
+			continue
+		}
+		seen[item] = struct{}{}
+		newList.Items = append(newList.Items, item)
+	}
+
+	return newList
+}
+
+// SymmetricDifference returns a new StringList containing the distinct items which appear in
+// exactly one of the two lists: this list's non-other items first, followed by other's
+// non-list items.
+func (list StringList) SymmetricDifference(other StringList) StringList {
+	listSet := toSet(list.Items)
+	otherSet := toSet(other.Items)
+	seen := make(map[string]struct{}, len(list.Items)+len(other.Items))
+	var newList StringList
+	newList.Items = make([]string, 0, len(list.Items)+len(other.Items))
+
+	for _, item := range list.Items {
+		if _, ok := otherSet[item]; ok {
+			continue
+		}
+		if _, ok := seen[item]; !ok {
+			seen[item] = struct{}{}
+			newList.Items = append(newList.Items, item)
+		}
+	}
+	for _, item := range other.Items {
+		if _, ok := listSet[item]; ok {
+			continue
+		}
+		if _, ok := seen[item]; !ok {
+			seen[item] = struct{}{}
+			newList.Items = append(newList.Items, item)
+		}
+	}
+
+	return newList
+}
+
+// toSet builds a membership set out of items.
+func toSet(items []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(items))
+	for _, item := range items {
+		set[item] = struct{}{}
+	}
+	return set
+}
+
+// skipRun advances past every item in items starting at i which is equal to items[i], returning
+// the index of the next distinct item (or len(items)).
+func skipRun(items []string, i int) int {
+	value := items[i]
+	for i < len(items) && items[i] == value {
+		i++
+	}
+	return i
+}
+
+// SortedUnion is a faster equivalent of Union for the case where both this list and other are
+// already sorted in ascending order. It runs in linear time via a merge and never allocates a
+// hash set. It returns the same items as Union, but in ascending order rather than in order of
+// first appearance.
+func (list StringList) SortedUnion(other StringList) StringList {
+	var newList StringList
+	newList.Items = make([]string, 0, len(list.Items)+len(other.Items))
+
+	i, j := 0, 0
+	for i < len(list.Items) && j < len(other.Items) {
+		a, b := list.Items[i], other.Items[j]
+		switch {
+		case a < b:
+			newList.Items = append(newList.Items, a)
+			i = skipRun(list.Items, i)
+		case a > b:
+			newList.Items = append(newList.Items, b)
+			j = skipRun(other.Items, j)
+		default:
+			newList.Items = append(newList.Items, a)
+			i = skipRun(list.Items, i)
+			j = skipRun(other.Items, j)
+		}
+	}
+	for i < len(list.Items) {
+		newList.Items = append(newList.Items, list.Items[i])
+		i = skipRun(list.Items, i)
+	}
+	for j < len(other.Items) {
+		newList.Items = append(newList.Items, other.Items[j])
+		j = skipRun(other.Items, j)
+	}
+
+	return newList
+}
+
+// SortedIntersect is a faster equivalent of Intersect for the case where both this list and other
+// are already sorted in ascending order. It runs in linear time via a merge and never allocates a
+// hash set.
+func (list StringList) SortedIntersect(other StringList) StringList {
+	var newList StringList
+	newList.Items = make([]string, 0, minInt(len(list.Items), len(other.Items)))
+
+	i, j := 0, 0
+	for i < len(list.Items) && j < len(other.Items) {
+		a, b := list.Items[i], other.Items[j]
+		switch {
+		case a < b:
+			i = skipRun(list.Items, i)
+		case a > b:
+			j = skipRun(other.Items, j)
+		default:
+			newList.Items = append(newList.Items, a)
+			i = skipRun(list.Items, i)
+			j = skipRun(other.Items, j)
+		}
+	}
+
+	return newList
+}
+
+// SortedDifference is a faster equivalent of Difference for the case where both this list and
+// other are already sorted in ascending order. It runs in linear time via a merge and never
+// allocates a hash set.
+func (list StringList) SortedDifference(other StringList) StringList {
+	var newList StringList
+	newList.Items = make([]string, 0, len(list.Items))
+
+	i, j := 0, 0
+	for i < len(list.Items) {
+		if j >= len(other.Items) || list.Items[i] < other.Items[j] {
+			newList.Items = append(newList.Items, list.Items[i])
+			i = skipRun(list.Items, i)
+		} else if list.Items[i] > other.Items[j] {
+			j = skipRun(other.Items, j)
+		} else {
+			i = skipRun(list.Items, i)
+			j = skipRun(other.Items, j)
+		}
+	}
+
+	return newList
+}
+
+// SortedSymmetricDifference is a faster equivalent of SymmetricDifference for the case where both
+// this list and other are already sorted in ascending order. It runs in linear time via a merge
+// and never allocates a hash set. It returns the same items as SymmetricDifference, but merged
+// into one ascending sequence rather than this list's items followed by other's.
+func (list StringList) SortedSymmetricDifference(other StringList) StringList {
+	var newList StringList
+	newList.Items = make([]string, 0, len(list.Items)+len(other.Items))
+
+	i, j := 0, 0
+	for i < len(list.Items) && j < len(other.Items) {
+		a, b := list.Items[i], other.Items[j]
+		switch {
+		case a < b:
+			newList.Items = append(newList.Items, a)
+			i = skipRun(list.Items, i)
+		case a > b:
+			newList.Items = append(newList.Items, b)
+			j = skipRun(other.Items, j)
+		default:
+			i = skipRun(list.Items, i)
+			j = skipRun(other.Items, j)
+		}
+	}
+	for i < len(list.Items) {
+		newList.Items = append(newList.Items, list.Items[i])
+		i = skipRun(list.Items, i)
+	}
+	for j < len(other.Items) {
+		newList.Items = append(newList.Items, other.Items[j])
+		j = skipRun(other.Items, j)
+	}
+
+	return newList
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// Unique removes duplicate items in place, preserving the order of each item's first occurrence.
+func (list *StringList) Unique() {
+	seen := make(map[string]struct{}, len(list.Items))
+	result := list.Items[:0]
+	for _, item := range list.Items {
+		if _, ok := seen[item]; ok {
+			continue
+		}
+		seen[item] = struct{}{}
+		result = append(result, item)
+	}
+	list.Items = result
+}
+
+// Counts returns a map of each distinct item in the list to the number of times it occurs.
+func (list StringList) Counts() map[string]int {
+	counts := make(map[string]int, len(list.Items))
+	for _, item := range list.Items {
+		counts[item]++
+	}
+	return counts
+}
+
+// AddAll appends all of other's items to the list.
+func (list *StringList) AddAll(other StringList) {
+	list.Items = append(list.Items, other.Items...)
+}
+
+// RemoveAll removes every item from the list which appears anywhere in other, preserving order.
+func (list *StringList) RemoveAll(other StringList) {
+	remove := toSet(other.Items)
+	result := list.Items[:0]
+	for _, item := range list.Items {
+		if _, ok := remove[item]; ok {
+			continue
+		}
+		result = append(result, item)
+	}
+	list.Items = result
+}
@@ -0,0 +1,114 @@
+package gostringlist
+
+import "testing"
+
+func TestUnion(t *testing.T) {
+	var list, list2, compareList StringList
+	list.Items = []string{"a", "b", "c"}
+	list2.Items = []string{"b", "c", "d"}
+	compareList.Items = []string{"a", "b", "c", "d"}
+
+	if out := list.Union(list2); !out.IsEqual(compareList) {
+		t.Fatalf("Union failed to combine lists correctly\n%s\n", out.ToString())
+	}
+}
+
+func TestIntersect(t *testing.T) {
+	var list, list2, compareList StringList
+	list.Items = []string{"a", "b", "c"}
+	list2.Items = []string{"b", "c", "d"}
+	compareList.Items = []string{"b", "c"}
+
+	if out := list.Intersect(list2); !out.IsEqual(compareList) {
+		t.Fatalf("Intersect failed to find common items\n%s\n", out.ToString())
+	}
+}
+
+func TestDifference(t *testing.T) {
+	var list, list2, compareList StringList
+	list.Items = []string{"a", "b", "c"}
+	list2.Items = []string{"b", "c", "d"}
+	compareList.Items = []string{"a"}
+
+	if out := list.Difference(list2); !out.IsEqual(compareList) {
+		t.Fatalf("Difference failed to exclude items correctly\n%s\n", out.ToString())
+	}
+}
+
+func TestSymmetricDifference(t *testing.T) {
+	var list, list2, compareList StringList
+	list.Items = []string{"a", "b", "c"}
+	list2.Items = []string{"b", "c", "d"}
+	compareList.Items = []string{"a", "d"}
+
+	if out := list.SymmetricDifference(list2); !out.IsEqual(compareList) {
+		t.Fatalf("SymmetricDifference failed to find items correctly\n%s\n", out.ToString())
+	}
+}
+
+func TestSortedSetOps(t *testing.T) {
+	var list, list2 StringList
+	list.Items = []string{"a", "a", "b", "c"}
+	list2.Items = []string{"b", "c", "c", "d"}
+
+	if out := list.SortedUnion(list2); !out.IsEqual(StringList{Items: []string{"a", "b", "c", "d"}}) {
+		t.Fatalf("SortedUnion failed to combine lists correctly\n%s\n", out.ToString())
+	}
+
+	if out := list.SortedIntersect(list2); !out.IsEqual(StringList{Items: []string{"b", "c"}}) {
+		t.Fatalf("SortedIntersect failed to find common items\n%s\n", out.ToString())
+	}
+
+	if out := list.SortedDifference(list2); !out.IsEqual(StringList{Items: []string{"a"}}) {
+		t.Fatalf("SortedDifference failed to exclude items correctly\n%s\n", out.ToString())
+	}
+
+	if out := list.SortedSymmetricDifference(list2); !out.IsEqual(StringList{Items: []string{"a", "d"}}) {
+		t.Fatalf("SortedSymmetricDifference failed to find items correctly\n%s\n", out.ToString())
+	}
+}
+
+func TestUnique(t *testing.T) {
+	var list, compareList StringList
+	list.Items = []string{"a", "b", "a", "c", "b"}
+	compareList.Items = []string{"a", "b", "c"}
+
+	list.Unique()
+	if !list.IsEqual(compareList) {
+		t.Fatalf("Unique failed to dedupe list correctly\n%s\n", list.ToString())
+	}
+}
+
+func TestCounts(t *testing.T) {
+	var list StringList
+	list.Items = []string{"a", "b", "a", "c", "b", "a"}
+
+	counts := list.Counts()
+	if counts["a"] != 3 || counts["b"] != 2 || counts["c"] != 1 {
+		t.Fatalf("Counts failed to count items correctly: %v", counts)
+	}
+}
+
+func TestAddAll(t *testing.T) {
+	var list, list2, compareList StringList
+	list.Items = []string{"a", "b"}
+	list2.Items = []string{"c", "d"}
+	compareList.Items = []string{"a", "b", "c", "d"}
+
+	list.AddAll(list2)
+	if !list.IsEqual(compareList) {
+		t.Fatalf("AddAll failed to append items correctly\n%s\n", list.ToString())
+	}
+}
+
+func TestRemoveAll(t *testing.T) {
+	var list, list2, compareList StringList
+	list.Items = []string{"a", "b", "c", "d"}
+	list2.Items = []string{"b", "d"}
+	compareList.Items = []string{"a", "c"}
+
+	list.RemoveAll(list2)
+	if !list.IsEqual(compareList) {
+		t.Fatalf("RemoveAll failed to remove items correctly\n%s\n", list.ToString())
+	}
+}
@@ -0,0 +1,183 @@
+package gostringlist
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/norm"
+)
+
+// SortOptions configures SortWith. The zero value sorts ascending in byte-lexicographic order,
+// the same order Sort() produces.
+type SortOptions struct {
+	// Descending reverses the sort order.
+	Descending bool
+
+	// Fold compares items case-insensitively.
+	Fold bool
+
+	// Natural compares embedded runs of digits numerically rather than byte-by-byte, so that
+	// "file2" sorts before "file10".
+	Natural bool
+
+	// Language, if not the zero value, sorts using that locale's collation order (e.g. proper
+	// Swedish or Turkish ordering) instead of byte-lexicographic order. Language and Natural are
+	// mutually exclusive; Natural takes precedence if both are set.
+	Language language.Tag
+}
+
+// SortWith sorts the list according to opts instead of Sort()'s plain ascending byte-lexicographic
+// order. The sort is stable: items which compare equal keep their relative order.
+func (list *StringList) SortWith(opts SortOptions) {
+	compare := compareFunc(opts)
+	less := func(i, j int) bool {
+		c := compare(list.Items[i], list.Items[j])
+		if opts.Descending {
+			return c > 0
+		}
+		return c < 0
+	}
+	sort.SliceStable(list.Items, less)
+}
+
+// compareFunc builds a three-way string comparison function from opts.
+func compareFunc(opts SortOptions) func(a, b string) int {
+	switch {
+	case opts.Natural:
+		return func(a, b string) int { return naturalCompare(a, b, opts.Fold) }
+	case opts.Language != language.Und:
+		var collOpts []collate.Option
+		if opts.Fold {
+			collOpts = append(collOpts, collate.IgnoreCase)
+		}
+		return collate.New(opts.Language, collOpts...).CompareString
+	case opts.Fold:
+		return func(a, b string) int { return strings.Compare(foldCase(a), foldCase(b)) }
+	default:
+		return strings.Compare
+	}
+}
+
+// naturalCompare compares a and b the way a human would sort filenames: runs of digits are
+// compared by numeric value instead of byte value, so "file2" sorts before "file10" even though
+// '1' < '2' as bytes.
+func naturalCompare(a, b string, fold bool) int {
+	if fold {
+		a, b = foldCase(a), foldCase(b)
+	}
+	ar, br := []rune(a), []rune(b)
+
+	i, j := 0, 0
+	for i < len(ar) && j < len(br) {
+		if unicode.IsDigit(ar[i]) && unicode.IsDigit(br[j]) {
+			starti := i
+			for i < len(ar) && unicode.IsDigit(ar[i]) {
+				i++
+			}
+			startj := j
+			for j < len(br) && unicode.IsDigit(br[j]) {
+				j++
+			}
+
+			if c := compareDigitRuns(ar[starti:i], br[startj:j]); c != 0 {
+				return c
+			}
+			continue
+		}
+
+		if ar[i] != br[j] {
+			if ar[i] < br[j] {
+				return -1
+			}
+			return 1
+		}
+		i++
+		j++
+	}
+
+	switch remA, remB := len(ar)-i, len(br)-j; {
+	case remA < remB:
+		return -1
+	case remA > remB:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// compareDigitRuns compares two runs of digits by numeric value, ignoring any leading zeros.
+func compareDigitRuns(a, b []rune) int {
+	a = dropLeadingZeros(a)
+	b = dropLeadingZeros(b)
+
+	if len(a) != len(b) {
+		if len(a) < len(b) {
+			return -1
+		}
+		return 1
+	}
+
+	return strings.Compare(string(a), string(b))
+}
+
+func dropLeadingZeros(digits []rune) []rune {
+	i := 0
+	for i < len(digits)-1 && digits[i] == '0' {
+		i++
+	}
+	return digits[i:]
+}
+
+// foldCase returns str with Unicode case folding applied, making it suitable for case-insensitive
+// comparison.
+func foldCase(str string) string {
+	return cases.Fold().String(str)
+}
+
+// ContainsFold returns true if the list contains a case-insensitive match of the specified string.
+func (list StringList) ContainsFold(str string) bool {
+	folded := foldCase(str)
+	for _, v := range list.Items {
+		if foldCase(v) == folded {
+			return true
+		}
+	}
+	return false
+}
+
+// IndexOfFold returns the index of the first case-insensitive match of the specified string, or
+// -1 if not found.
+func (list StringList) IndexOfFold(str string) int {
+	folded := foldCase(str)
+	for i, v := range list.Items {
+		if foldCase(v) == folded {
+			return i
+		}
+	}
+	return -1
+}
+
+// RemoveFold deletes the first case-insensitive match of str from the list, preserving order. It
+// otherwise behaves like Remove().
+func (list *StringList) RemoveFold(str string) {
+	index := list.IndexOfFold(str)
+	if index < 0 {
+		return
+	}
+
+	copy(list.Items[index:], list.Items[index+1:])
+	list.Items = list.Items[:len(list.Items)-1]
+}
+
+// Normalize rewrites every item in the list to the given Unicode normalization form (e.g.
+// norm.NFC or norm.NFD), so that items which differ only in how accented characters are encoded
+// compare and sort consistently.
+func (list *StringList) Normalize(form norm.Form) {
+	for i, item := range list.Items {
+		list.Items[i] = form.String(item)
+	}
+}
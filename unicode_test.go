@@ -0,0 +1,108 @@
+package gostringlist
+
+import (
+	"testing"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/norm"
+)
+
+func TestSortWithDescending(t *testing.T) {
+	var list, list2 StringList
+
+	list.Items = []string{"b", "a", "d", "c"}
+	list2.Items = []string{"d", "c", "b", "a"}
+
+	list.SortWith(SortOptions{Descending: true})
+	if !list.IsEqual(list2) {
+		t.Fatalf("SortWith failed to sort descending\n%s\n", list.ToString())
+	}
+}
+
+func TestSortWithFold(t *testing.T) {
+	var list, list2 StringList
+
+	list.Items = []string{"Banana", "apple", "Cherry"}
+	list2.Items = []string{"apple", "Banana", "Cherry"}
+
+	list.SortWith(SortOptions{Fold: true})
+	if !list.IsEqual(list2) {
+		t.Fatalf("SortWith failed to fold case when sorting\n%s\n", list.ToString())
+	}
+}
+
+func TestSortWithNatural(t *testing.T) {
+	var list, list2 StringList
+
+	list.Items = []string{"file10", "file2", "file1"}
+	list2.Items = []string{"file1", "file2", "file10"}
+
+	list.SortWith(SortOptions{Natural: true})
+	if !list.IsEqual(list2) {
+		t.Fatalf("SortWith failed to sort numerically\n%s\n", list.ToString())
+	}
+}
+
+func TestSortWithLanguage(t *testing.T) {
+	var list, list2 StringList
+
+	// In Swedish collation, "z" < "å" < "ä", unlike plain byte order, which puts "ä" (U+00E4)
+	// before "å" (U+00E5).
+	list.Items = []string{"ä", "å", "z"}
+	list2.Items = []string{"z", "å", "ä"}
+
+	list.SortWith(SortOptions{Language: language.Swedish})
+	if !list.IsEqual(list2) {
+		t.Fatalf("SortWith failed to apply locale-sensitive ordering\n%s\n", list.ToString())
+	}
+}
+
+func TestContainsFold(t *testing.T) {
+	var list StringList
+	list.Items = []string{"Apple", "banana"}
+
+	if !list.ContainsFold("APPLE") {
+		t.Fatal("ContainsFold failed to find a case-insensitive match")
+	}
+
+	if list.ContainsFold("cherry") {
+		t.Fatal("ContainsFold found a nonexistent entry")
+	}
+}
+
+func TestIndexOfFold(t *testing.T) {
+	var list StringList
+	list.Items = []string{"Apple", "banana"}
+
+	if list.IndexOfFold("BANANA") != 1 {
+		t.Fatal("IndexOfFold failed to return correct index")
+	}
+
+	if list.IndexOfFold("cherry") >= 0 {
+		t.Fatal("IndexOfFold failed to return correct index for nonexistent entry")
+	}
+}
+
+func TestRemoveFold(t *testing.T) {
+	var list, list2 StringList
+
+	list.Items = []string{"Apple", "banana", "Cherry"}
+	list2.Items = []string{"Apple", "Cherry"}
+
+	list.RemoveFold("BANANA")
+	if !list.IsEqual(list2) {
+		t.Fatalf("RemoveFold failed to remove item correctly\n%s\n", list.ToString())
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	var list StringList
+
+	// "é" as a single precomposed rune vs. "e" + combining acute accent.
+	list.Items = []string{"é"}
+
+	list.Normalize(norm.NFC)
+	if list.Items[0] != "é" {
+		t.Fatalf("Normalize failed to normalize to NFC, got %q", list.Items[0])
+	}
+}